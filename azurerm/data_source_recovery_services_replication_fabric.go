@@ -0,0 +1,74 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmRecoveryServicesReplicationFabric() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmRecoveryServicesReplicationFabricRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"location": locationForDataSourceSchema(),
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmRecoveryServicesReplicationFabricRead(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	vault := d.Get("vault_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	client := meta.(*ArmClient).getReplicationFabricClientForRecoveryServicesVault(resourceGroup, vault)
+	ctx := meta.(*ArmClient).StopContext
+
+	resp, err := client.Get(ctx, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Recovery Service Replication Fabric %q (Resource Group %q, Vault %q) was not found", name, resourceGroup, vault)
+		}
+
+		return fmt.Errorf("Error making Read request on Recovery Service Replication Fabric %q (Resource Group %q, Vault %q): %+v", name, resourceGroup, vault, err)
+	}
+
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("Cannot read ID for Recovery Service Replication Fabric %q (Resource Group %q, Vault %q)", name, resourceGroup, vault)
+	}
+
+	d.SetId(*resp.ID)
+
+	fabricType, location, _, _ := flattenFabricCustomDetails(resp.Properties.CustomDetails)
+
+	if fabricType != "" {
+		d.Set("type", fabricType)
+	}
+
+	if location != "" {
+		d.Set("location", azureRMNormalizeLocation(location))
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceAzureRMRecoveryServicesReplicationFabric_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_recovery_services_replication_fabric.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesReplicationFabricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMRecoveryServicesReplicationFabric_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "type", "Azure"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "location"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRecoveryServicesReplicationFabric_importByTriple(t *testing.T) {
+	resourceName := "azurerm_recovery_services_replication_fabric.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesReplicationFabricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesReplicationFabric_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesReplicationFabricExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", resourceName)
+					}
+
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["resource_group_name"], rs.Primary.Attributes["vault_name"], rs.Primary.Attributes["name"]), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMRecoveryServicesReplicationFabric_basic(rInt int, location string) string {
+	template := testAccAzureRMRecoveryServicesReplicationFabric_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_recovery_services_replication_fabric" "test" {
+  name                = azurerm_recovery_services_replication_fabric.test.name
+  resource_group_name = azurerm_recovery_services_replication_fabric.test.resource_group_name
+  vault_name          = azurerm_recovery_services_replication_fabric.test.vault_name
+}
+`, template)
+}
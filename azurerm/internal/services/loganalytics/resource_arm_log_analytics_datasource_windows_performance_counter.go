@@ -9,7 +9,6 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/operationalinsights/mgmt/2015-11-01-preview/operationalinsights"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
@@ -59,27 +58,74 @@ func resourceArmLogAnalyticsDataSourceWindowsPerformanceCounter() *schema.Resour
 			},
 
 			"counter_name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Deprecated:    "Deprecated in favour of `performance_counter`",
+				ConflictsWith: []string{"performance_counter"},
+				AtLeastOneOf:  []string{"counter_name", "instance_name", "interval_seconds", "object_name", "performance_counter"},
+				ValidateFunc:  validation.StringIsNotEmpty,
 			},
 
 			"instance_name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Deprecated:    "Deprecated in favour of `performance_counter`",
+				ConflictsWith: []string{"performance_counter"},
+				AtLeastOneOf:  []string{"counter_name", "instance_name", "interval_seconds", "object_name", "performance_counter"},
+				ValidateFunc:  validation.StringIsNotEmpty,
 			},
 
 			"interval_seconds": {
-				Type:         schema.TypeInt,
-				Required:     true,
-				ValidateFunc: validation.IntBetween(10, math.MaxInt32),
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Deprecated:    "Deprecated in favour of `performance_counter`",
+				ConflictsWith: []string{"performance_counter"},
+				AtLeastOneOf:  []string{"counter_name", "instance_name", "interval_seconds", "object_name", "performance_counter"},
+				ValidateFunc:  validation.IntBetween(10, math.MaxInt32),
 			},
 
 			"object_name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Deprecated:    "Deprecated in favour of `performance_counter`",
+				ConflictsWith: []string{"performance_counter"},
+				AtLeastOneOf:  []string{"counter_name", "instance_name", "interval_seconds", "object_name", "performance_counter"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"performance_counter": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MinItems:      1,
+				ConflictsWith: []string{"counter_name", "instance_name", "interval_seconds", "object_name"},
+				AtLeastOneOf:  []string{"counter_name", "instance_name", "interval_seconds", "object_name", "performance_counter"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"counter_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"instance_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"interval_seconds": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(10, math.MaxInt32),
+						},
+
+						"object_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
 			},
 		},
 	}
@@ -92,6 +138,37 @@ type dataSourceWindowsPerformanceCounterProperty struct {
 	ObjectName      string `json:"objectName"`
 }
 
+func expandLogAnalyticsDataSourceWindowsPerformanceCounters(input []interface{}) []dataSourceWindowsPerformanceCounterProperty {
+	counters := make([]dataSourceWindowsPerformanceCounterProperty, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		counters = append(counters, dataSourceWindowsPerformanceCounterProperty{
+			CounterName:     raw["counter_name"].(string),
+			InstanceName:    raw["instance_name"].(string),
+			IntervalSeconds: raw["interval_seconds"].(int),
+			ObjectName:      raw["object_name"].(string),
+		})
+	}
+
+	return counters
+}
+
+func flattenLogAnalyticsDataSourceWindowsPerformanceCounters(input []dataSourceWindowsPerformanceCounterProperty) []interface{} {
+	counters := make([]interface{}, 0)
+
+	for _, v := range input {
+		counters = append(counters, map[string]interface{}{
+			"counter_name":     v.CounterName,
+			"instance_name":    v.InstanceName,
+			"interval_seconds": v.IntervalSeconds,
+			"object_name":      v.ObjectName,
+		})
+	}
+
+	return counters
+}
+
 func resourceArmLogAnalyticsDataSourceWindowsPerformanceCounterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).LogAnalytics.DataSourcesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -114,16 +191,21 @@ func resourceArmLogAnalyticsDataSourceWindowsPerformanceCounterCreateUpdate(d *s
 		}
 	}
 
-	prop := &dataSourceWindowsPerformanceCounterProperty{
-		CounterName:     d.Get("counter_name").(string),
-		InstanceName:    d.Get("instance_name").(string),
-		IntervalSeconds: d.Get("interval_seconds").(int),
-		ObjectName:      d.Get("object_name").(string),
+	var props interface{}
+	if countersRaw, ok := d.GetOk("performance_counter"); ok {
+		props = expandLogAnalyticsDataSourceWindowsPerformanceCounters(countersRaw.([]interface{}))
+	} else {
+		props = &dataSourceWindowsPerformanceCounterProperty{
+			CounterName:     d.Get("counter_name").(string),
+			InstanceName:    d.Get("instance_name").(string),
+			IntervalSeconds: d.Get("interval_seconds").(int),
+			ObjectName:      d.Get("object_name").(string),
+		}
 	}
 
 	params := operationalinsights.DataSource{
 		Kind:       operationalinsights.WindowsPerformanceCounter,
-		Properties: prop,
+		Properties: props,
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, workspaceName, name, params); err != nil {
@@ -169,20 +251,27 @@ func resourceArmLogAnalyticsDataSourceWindowsPerformanceCounterRead(d *schema.Re
 	d.Set("resource_group_name", id.ResourceGroup)
 	d.Set("workspace_name", id.Workspace)
 	if props := resp.Properties; props != nil {
-		propStr, err := structure.FlattenJsonToString(props.(map[string]interface{}))
+		propBytes, err := json.Marshal(props)
 		if err != nil {
-			return fmt.Errorf("failed to flatten properties map to json for Log Analytics DataSource Windows Performance Counter %q (Resource Group %q / Workspace: %q): %+v", id.Name, id.ResourceGroup, id.Workspace, err)
+			return fmt.Errorf("failed to marshal properties for Log Analytics DataSource Windows Performance Counter %q (Resource Group %q / Workspace: %q): %+v", id.Name, id.ResourceGroup, id.Workspace, err)
 		}
 
-		prop := &dataSourceWindowsPerformanceCounterProperty{}
-		if err := json.Unmarshal([]byte(propStr), &prop); err != nil {
-			return fmt.Errorf("failed to decode properties json for Log Analytics DataSource Windows Performance Counter %q (Resource Group %q / Workspace: %q): %+v", id.Name, id.ResourceGroup, id.Workspace, err)
-		}
+		var counters []dataSourceWindowsPerformanceCounterProperty
+		if err := json.Unmarshal(propBytes, &counters); err == nil && len(counters) > 0 {
+			if err := d.Set("performance_counter", flattenLogAnalyticsDataSourceWindowsPerformanceCounters(counters)); err != nil {
+				return fmt.Errorf("failed to set `performance_counter` for Log Analytics DataSource Windows Performance Counter %q (Resource Group %q / Workspace: %q): %+v", id.Name, id.ResourceGroup, id.Workspace, err)
+			}
+		} else {
+			prop := &dataSourceWindowsPerformanceCounterProperty{}
+			if err := json.Unmarshal(propBytes, &prop); err != nil {
+				return fmt.Errorf("failed to decode properties json for Log Analytics DataSource Windows Performance Counter %q (Resource Group %q / Workspace: %q): %+v", id.Name, id.ResourceGroup, id.Workspace, err)
+			}
 
-		d.Set("counter_name", prop.CounterName)
-		d.Set("instance_name", prop.InstanceName)
-		d.Set("interval_seconds", prop.IntervalSeconds)
-		d.Set("object_name", prop.ObjectName)
+			d.Set("counter_name", prop.CounterName)
+			d.Set("instance_name", prop.InstanceName)
+			d.Set("interval_seconds", prop.IntervalSeconds)
+			d.Set("object_name", prop.ObjectName)
+		}
 	}
 
 	return nil
@@ -0,0 +1,372 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2018-01-10/siterecovery"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRecoveryServicesReplicatedVm() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRecoveryServicesReplicatedVmCreate,
+		Read:   resourceArmRecoveryServicesReplicatedVmRead,
+		Delete: resourceArmRecoveryServicesReplicatedVmDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Replicated VM name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"recovery_vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Recovery Service Vault name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"source_recovery_fabric_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"source_vm_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"source_recovery_protection_container_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"recovery_replication_policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_resource_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_recovery_fabric_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_recovery_protection_container_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_network_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"managed_disk": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disk_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"target_disk_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(siterecovery.DiskAccountTypeStandardLRS),
+								string(siterecovery.DiskAccountTypePremiumLRS),
+							}, false),
+						},
+
+						"target_storage_account_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandArmRecoveryServicesReplicatedVmManagedDisks(input []interface{}) *[]siterecovery.A2AVMDiskInputDetails {
+	disks := make([]siterecovery.A2AVMDiskInputDetails, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		diskID := raw["disk_id"].(string)
+		targetDiskType := raw["target_disk_type"].(string)
+		targetStorageAccountID := raw["target_storage_account_id"].(string)
+
+		disks = append(disks, siterecovery.A2AVMDiskInputDetails{
+			DiskID:                         &diskID,
+			RecoveryAzureStorageAccountID:  &targetStorageAccountID,
+			RecoveryReplicaDiskAccountType: &targetDiskType,
+		})
+	}
+
+	return &disks
+}
+
+func resourceArmRecoveryServicesReplicatedVmCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	vault := d.Get("recovery_vault_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	sourceFabricName := d.Get("source_recovery_fabric_name").(string)
+	sourceContainerName := d.Get("source_recovery_protection_container_name").(string)
+	sourceVmID := d.Get("source_vm_id").(string)
+	policyID := d.Get("recovery_replication_policy_id").(string)
+	targetResourceGroupID := d.Get("target_resource_group_id").(string)
+	targetFabricID := d.Get("target_recovery_fabric_id").(string)
+	targetContainerID := d.Get("target_recovery_protection_container_id").(string)
+
+	client := meta.(*ArmClient).getReplicationMigrationItemClientForRecoveryServicesVault(resourceGroup, vault)
+
+	log.Printf("[DEBUG] Creating/updating Recovery Service Replicated VM %q (resource group %q, vault %q, fabric %q, container %q)", name, resourceGroup, vault, sourceFabricName, sourceContainerName)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, sourceFabricName, sourceContainerName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Recovery Service Replicated VM %q (Resource Group %q, vault %q, fabric %q, container %q): %+v", name, resourceGroup, vault, sourceFabricName, sourceContainerName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_recovery_services_replicated_vm", *existing.ID)
+		}
+	}
+
+	providerInput := siterecovery.A2AReplicationInput{
+		InstanceType:                 siterecovery.InstanceTypeA2A,
+		RecoveryAzureResourceGroupID: &targetResourceGroupID,
+		RecoveryCloudServiceID:       &targetFabricID,
+		RecoveryContainerID:          &targetContainerID,
+		VMDisks:                      expandArmRecoveryServicesReplicatedVmManagedDisks(d.Get("managed_disk").([]interface{})),
+	}
+
+	if targetNetworkID, ok := d.GetOk("target_network_id"); ok {
+		networkID := targetNetworkID.(string)
+		providerInput.RecoveryVirtualNetworkID = &networkID
+	}
+
+	item := siterecovery.EnableProtectionInput{
+		Properties: &siterecovery.EnableProtectionInputProperties{
+			PolicyID:                &policyID,
+			ProtectableItemID:       &sourceVmID,
+			ProviderSpecificDetails: providerInput,
+		},
+	}
+
+	future, err := client.Create(ctx, sourceFabricName, sourceContainerName, name, item)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Replicated VM %q (Resource Group %q, Vault %q, Fabric %q, Container %q): %+v", name, resourceGroup, vault, sourceFabricName, sourceContainerName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Replicated VM %q (Resource Group %q, Vault %q, Fabric %q, Container %q): %+v", name, resourceGroup, vault, sourceFabricName, sourceContainerName, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Replicated VM %q (Resource Group %q, Vault %q, Fabric %q, Container %q): %+v", name, resourceGroup, vault, sourceFabricName, sourceContainerName, err)
+	}
+
+	d.SetId(*result.ID)
+
+	return resourceArmRecoveryServicesReplicatedVmRead(d, meta)
+}
+
+func resourceArmRecoveryServicesReplicatedVmRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vault := id.Path["vaults"]
+	fabricName := id.Path["replicationFabrics"]
+	containerName := id.Path["replicationProtectionContainers"]
+	name := id.Path["replicationMigrationItems"]
+	resourceGroup := id.ResourceGroup
+
+	client := meta.(*ArmClient).getReplicationMigrationItemClientForRecoveryServicesVault(resourceGroup, vault)
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[DEBUG] Reading Recovery Service Replicated VM %q (resource group %q)", name, resourceGroup)
+
+	resp, err := client.Get(ctx, fabricName, containerName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Recovery Service Replicated VM %q (Resource Group %q, Vault %q, Fabric %q, Container %q): %+v", name, resourceGroup, vault, fabricName, containerName, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vault)
+	d.Set("source_recovery_fabric_name", fabricName)
+	d.Set("source_recovery_protection_container_name", containerName)
+
+	if props := resp.Properties; props != nil {
+		if props.PolicyID != nil {
+			d.Set("recovery_replication_policy_id", props.PolicyID)
+		}
+
+		if details, isA2A := props.ProviderSpecificDetails.AsA2AReplicationDetails(); isA2A {
+			if details.FabricObjectID != nil {
+				d.Set("source_vm_id", details.FabricObjectID)
+			}
+
+			if details.RecoveryAzureResourceGroupID != nil {
+				d.Set("target_resource_group_id", details.RecoveryAzureResourceGroupID)
+			}
+
+			if details.RecoveryCloudServiceID != nil {
+				d.Set("target_recovery_fabric_id", details.RecoveryCloudServiceID)
+			}
+
+			if details.RecoveryContainerID != nil {
+				d.Set("target_recovery_protection_container_id", details.RecoveryContainerID)
+			}
+
+			if details.RecoveryVirtualNetworkID != nil {
+				d.Set("target_network_id", details.RecoveryVirtualNetworkID)
+			}
+
+			if err := d.Set("managed_disk", flattenArmRecoveryServicesReplicatedVmManagedDisks(details.ProtectedDisks)); err != nil {
+				return fmt.Errorf("Error setting `managed_disk`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func flattenArmRecoveryServicesReplicatedVmManagedDisks(input *[]siterecovery.A2AProtectedDiskDetails) []interface{} {
+	disks := make([]interface{}, 0)
+	if input == nil {
+		return disks
+	}
+
+	for _, disk := range *input {
+		diskID := ""
+		if disk.DiskID != nil {
+			diskID = *disk.DiskID
+		}
+
+		targetDiskType := ""
+		if disk.RecoveryReplicaDiskAccountType != nil {
+			targetDiskType = *disk.RecoveryReplicaDiskAccountType
+		}
+
+		targetStorageAccountID := ""
+		if disk.RecoveryAzureStorageAccountID != nil {
+			targetStorageAccountID = *disk.RecoveryAzureStorageAccountID
+		}
+
+		disks = append(disks, map[string]interface{}{
+			"disk_id":                    diskID,
+			"target_disk_type":           targetDiskType,
+			"target_storage_account_id":  targetStorageAccountID,
+		})
+	}
+
+	return disks
+}
+
+func resourceArmRecoveryServicesReplicatedVmDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vault := id.Path["vaults"]
+	fabricName := id.Path["replicationFabrics"]
+	containerName := id.Path["replicationProtectionContainers"]
+	name := id.Path["replicationMigrationItems"]
+	resourceGroup := id.ResourceGroup
+
+	client := meta.(*ArmClient).getReplicationMigrationItemClientForRecoveryServicesVault(resourceGroup, vault)
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[DEBUG] Deleting Recovery Service Replicated VM %q (resource group %q, vault %q, fabric %q, container %q)", name, resourceGroup, vault, fabricName, containerName)
+
+	disableInput := siterecovery.DisableProtectionInput{
+		Properties: &siterecovery.DisableProtectionInputProperties{
+			DisableProtectionReason: siterecovery.NotSpecified,
+		},
+	}
+
+	future, err := client.Delete(ctx, fabricName, containerName, name, disableInput)
+	if err != nil {
+		return fmt.Errorf("Error issuing delete request for Recovery Service Replicated VM %q (Resource Group %q, Vault %q, Fabric %q, Container %q): %+v", name, resourceGroup, vault, fabricName, containerName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error issuing delete request for Recovery Service Replicated VM %q (Resource Group %q, Vault %q, Fabric %q, Container %q): %+v", name, resourceGroup, vault, fabricName, containerName, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Deletion request failed for Recovery Service Replicated VM %q (Resource Group %q, Vault %q, Fabric %q, Container %q): %+v", name, resourceGroup, vault, fabricName, containerName, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,234 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRecoveryServicesReplicatedVm_basic(t *testing.T) {
+	resourceName := "azurerm_recovery_services_replicated_vm.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesReplicatedVmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesReplicatedVm_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesReplicatedVmExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRecoveryServicesReplicatedVmDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_recovery_services_replicated_vm" {
+			continue
+		}
+
+		vault := rs.Primary.Attributes["recovery_vault_name"]
+		fabricName := rs.Primary.Attributes["source_recovery_fabric_name"]
+		containerName := rs.Primary.Attributes["source_recovery_protection_container_name"]
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).getReplicationMigrationItemClientForRecoveryServicesVault(resourceGroup, vault)
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, fabricName, containerName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Recovery Service Replicated VM still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMRecoveryServicesReplicatedVmExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		vault := rs.Primary.Attributes["recovery_vault_name"]
+		fabricName := rs.Primary.Attributes["source_recovery_fabric_name"]
+		containerName := rs.Primary.Attributes["source_recovery_protection_container_name"]
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).getReplicationMigrationItemClientForRecoveryServicesVault(resourceGroup, vault)
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, fabricName, containerName, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on replicationMigrationItemsClient: %+v", err)
+		}
+
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Bad: Recovery Service Replicated VM %q (resource group: %q, vault: %q, fabric: %q, container: %q) does not exist", name, resourceGroup, vault, fabricName, containerName)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMRecoveryServicesReplicatedVm_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_group" "target" {
+  name     = "acctestRG-target-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-vault-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_recovery_services_replication_fabric" "source" {
+  name                = "acctest-fabric-source-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  vault_name          = azurerm_recovery_services_vault.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_recovery_services_replication_fabric" "target" {
+  name                = "acctest-fabric-target-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  vault_name          = azurerm_recovery_services_vault.test.name
+  location            = azurerm_resource_group.target.location
+}
+
+resource "azurerm_recovery_services_replication_protection_container" "source" {
+  name                 = "acctest-container-source-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  recovery_vault_name  = azurerm_recovery_services_vault.test.name
+  recovery_fabric_name = azurerm_recovery_services_replication_fabric.source.name
+}
+
+resource "azurerm_recovery_services_replication_protection_container" "target" {
+  name                 = "acctest-container-target-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  recovery_vault_name  = azurerm_recovery_services_vault.test.name
+  recovery_fabric_name = azurerm_recovery_services_replication_fabric.target.name
+}
+
+resource "azurerm_recovery_services_replication_policy" "test" {
+  name                = "acctest-policy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  vault_name          = azurerm_recovery_services_vault.test.name
+}
+
+resource "azurerm_recovery_services_replication_protection_container_mapping" "test" {
+  name                                       = "acctest-mapping-%d"
+  resource_group_name                        = azurerm_resource_group.test.name
+  recovery_vault_name                        = azurerm_recovery_services_vault.test.name
+  recovery_fabric_name                       = azurerm_recovery_services_replication_fabric.source.name
+  recovery_source_protection_container_name  = azurerm_recovery_services_replication_protection_container.source.name
+  recovery_target_protection_container_id    = azurerm_recovery_services_replication_protection_container.target.id
+  recovery_replication_policy_id             = azurerm_recovery_services_replication_policy.test.id
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctest-nic-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_virtual_machine" "test" {
+  name                  = "acctest-vm-%d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  network_interface_ids = [azurerm_network_interface.test.id]
+  vm_size               = "Standard_D2s_v3"
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "acctest-osdisk-%d"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "acctest-vm-%d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+}
+
+resource "azurerm_recovery_services_replicated_vm" "test" {
+  name                                       = "acctest-rep-%d"
+  resource_group_name                        = azurerm_resource_group.test.name
+  recovery_vault_name                        = azurerm_recovery_services_vault.test.name
+  source_recovery_fabric_name                = azurerm_recovery_services_replication_fabric.source.name
+  source_vm_id                               = azurerm_virtual_machine.test.id
+  source_recovery_protection_container_name  = azurerm_recovery_services_replication_protection_container.source.name
+  recovery_replication_policy_id             = azurerm_recovery_services_replication_policy.test.id
+  target_resource_group_id                   = azurerm_resource_group.target.id
+  target_recovery_fabric_id                  = azurerm_recovery_services_replication_fabric.target.id
+  target_recovery_protection_container_id    = azurerm_recovery_services_replication_protection_container.target.id
+
+  managed_disk {
+    disk_id                   = azurerm_virtual_machine.test.storage_os_disk.0.managed_disk_id
+    target_disk_type          = "Standard_LRS"
+    target_storage_account_id = azurerm_resource_group.target.id
+  }
+
+  depends_on = [azurerm_recovery_services_replication_protection_container_mapping.test]
+}
+`, rInt, location, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt, rInt, rInt, rInt, rInt, rInt, rInt, rInt)
+}
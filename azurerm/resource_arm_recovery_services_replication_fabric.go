@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 
@@ -21,7 +22,7 @@ func resourceArmRecoveryServicesReplicationFabric() *schema.Resource {
 		Delete: resourceArmRecoveryServicesReplicationFabricDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceArmRecoveryServicesReplicationFabricImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -48,10 +49,131 @@ func resourceArmRecoveryServicesReplicationFabric() *schema.Resource {
 					"Recovery Service Vault name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
 				),
 			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(siterecovery.InstanceTypeAzure),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(siterecovery.InstanceTypeAzure),
+					"VMware",
+					"HyperVSite",
+				}, false),
+			},
+
+			"vmware": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"process_server": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"ip_address": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"hyperv_site": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fqdn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"ip": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"version": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func expandArmRecoveryServicesReplicationFabricProcessServers(input []interface{}) *[]siterecovery.ProcessServer {
+	servers := make([]siterecovery.ProcessServer, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		name := raw["name"].(string)
+		ipAddress := raw["ip_address"].(string)
+
+		servers = append(servers, siterecovery.ProcessServer{
+			FriendlyName: &name,
+			IPAddress:    &ipAddress,
+		})
+	}
+
+	return &servers
+}
+
+func expandArmRecoveryServicesReplicationFabricHyperVHosts(input []interface{}) *[]siterecovery.HyperVHostDetails {
+	hosts := make([]siterecovery.HyperVHostDetails, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		fqdn := raw["fqdn"].(string)
+		ip := raw["ip"].(string)
+		version := raw["version"].(string)
+
+		hosts = append(hosts, siterecovery.HyperVHostDetails{
+			FQDN:      &fqdn,
+			IPAddress: &ip,
+			Version:   &version,
+		})
+	}
+
+	return &hosts
+}
+
 func resourceArmRecoveryServicesReplicationFabricCreate(d *schema.ResourceData, meta interface{}) error {
 	ctx := meta.(*ArmClient).StopContext
 
@@ -77,16 +199,49 @@ func resourceArmRecoveryServicesReplicationFabricCreate(d *schema.ResourceData,
 		}
 	}
 
-	// Build custom input for Azure fabric
-	azureInput := siterecovery.AzureFabricCreationInput{
-		Location:     &location,
-		InstanceType: siterecovery.InstanceTypeAzure,
+	fabricType := d.Get("type").(string)
+
+	var customDetails siterecovery.BasicFabricSpecificCreationInput
+	switch fabricType {
+	case "VMware":
+		vmwareRaw := d.Get("vmware").([]interface{})
+		if len(vmwareRaw) > 0 {
+			vmware := vmwareRaw[0].(map[string]interface{})
+			customDetails = siterecovery.VMwareV2FabricCreationInput{
+				InstanceType:   siterecovery.InstanceTypeVMwareV2,
+				ProcessServers: expandArmRecoveryServicesReplicationFabricProcessServers(vmware["process_server"].([]interface{})),
+			}
+		} else {
+			customDetails = siterecovery.VMwareV2FabricCreationInput{
+				InstanceType: siterecovery.InstanceTypeVMwareV2,
+			}
+		}
+
+	case "HyperVSite":
+		hyperVRaw := d.Get("hyperv_site").([]interface{})
+		if len(hyperVRaw) > 0 {
+			hyperV := hyperVRaw[0].(map[string]interface{})
+			customDetails = siterecovery.HyperVSiteCreationInput{
+				InstanceType: siterecovery.InstanceTypeHyperVSite,
+				Hosts:        expandArmRecoveryServicesReplicationFabricHyperVHosts(hyperV["host"].([]interface{})),
+			}
+		} else {
+			customDetails = siterecovery.HyperVSiteCreationInput{
+				InstanceType: siterecovery.InstanceTypeHyperVSite,
+			}
+		}
+
+	default:
+		customDetails = siterecovery.AzureFabricCreationInput{
+			Location:     &location,
+			InstanceType: siterecovery.InstanceTypeAzure,
+		}
 	}
 
 	//build fabric struct
 	fabric := siterecovery.FabricCreationInput{
 		Properties: &siterecovery.FabricCreationInputProperties{
-			CustomDetails: azureInput,
+			CustomDetails: customDetails,
 		},
 	}
 
@@ -139,16 +294,114 @@ func resourceArmRecoveryServicesReplicationFabricRead(d *schema.ResourceData, me
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resourceGroup)
 	d.Set("vault_name", vault)
-	azureDetails, isAzure := resp.Properties.CustomDetails.AsAzureFabricSpecificDetails()
-	if isAzure {
-		if location := azureDetails.Location; location != nil {
-			d.Set("location", azureRMNormalizeLocation(*location))
-		}
+
+	fabricType, location, vmware, hyperVSite := flattenFabricCustomDetails(resp.Properties.CustomDetails)
+
+	if fabricType != "" {
+		d.Set("type", fabricType)
+	}
+
+	if location != "" {
+		d.Set("location", azureRMNormalizeLocation(location))
+	}
+
+	if err := d.Set("vmware", vmware); err != nil {
+		return fmt.Errorf("Error setting `vmware`: %+v", err)
+	}
+
+	if err := d.Set("hyperv_site", hyperVSite); err != nil {
+		return fmt.Errorf("Error setting `hyperv_site`: %+v", err)
 	}
 
 	return nil
 }
 
+// flattenFabricCustomDetails turns the polymorphic FabricSpecificDetails returned for an Azure,
+// VMware or Hyper-V fabric into the values shared by the fabric resource and data source.
+func flattenFabricCustomDetails(input siterecovery.BasicFabricSpecificDetails) (fabricType string, location string, vmware []interface{}, hyperVSite []interface{}) {
+	vmware = []interface{}{}
+	hyperVSite = []interface{}{}
+
+	if input == nil {
+		return
+	}
+
+	if azureDetails, isAzure := input.AsAzureFabricSpecificDetails(); isAzure {
+		fabricType = string(siterecovery.InstanceTypeAzure)
+		if azureDetails.Location != nil {
+			location = *azureDetails.Location
+		}
+	}
+
+	if vmwareDetails, isVMware := input.AsVMwareV2FabricSpecificDetails(); isVMware {
+		fabricType = "VMware"
+
+		processServers := make([]interface{}, 0)
+		if vmwareDetails.ProcessServers != nil {
+			for _, server := range *vmwareDetails.ProcessServers {
+				name := ""
+				if server.FriendlyName != nil {
+					name = *server.FriendlyName
+				}
+
+				ipAddress := ""
+				if server.IPAddress != nil {
+					ipAddress = *server.IPAddress
+				}
+
+				processServers = append(processServers, map[string]interface{}{
+					"name":       name,
+					"ip_address": ipAddress,
+				})
+			}
+		}
+
+		vmware = []interface{}{
+			map[string]interface{}{
+				"process_server": processServers,
+			},
+		}
+	}
+
+	if hyperVDetails, isHyperV := input.AsHyperVSiteDetails(); isHyperV {
+		fabricType = "HyperVSite"
+
+		hosts := make([]interface{}, 0)
+		if hyperVDetails.HyperVHosts != nil {
+			for _, host := range *hyperVDetails.HyperVHosts {
+				fqdn := ""
+				if host.FQDN != nil {
+					fqdn = *host.FQDN
+				}
+
+				ip := ""
+				if host.IPAddress != nil {
+					ip = *host.IPAddress
+				}
+
+				version := ""
+				if host.Version != nil {
+					version = *host.Version
+				}
+
+				hosts = append(hosts, map[string]interface{}{
+					"fqdn":    fqdn,
+					"ip":      ip,
+					"version": version,
+				})
+			}
+		}
+
+		hyperVSite = []interface{}{
+			map[string]interface{}{
+				"host": hosts,
+			},
+		}
+	}
+
+	return
+}
+
 func resourceArmRecoveryServicesReplicationFabricDelete(d *schema.ResourceData, meta interface{}) error {
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -183,3 +436,37 @@ func resourceArmRecoveryServicesReplicationFabricDelete(d *schema.ResourceData,
 
 	return nil
 }
+
+// resourceArmRecoveryServicesReplicationFabricImport lets an existing fabric be imported either by
+// its full ARM resource ID, or by the shorthand `{resource_group}/{vault_name}/{fabric_name}` triple
+// that's easier to get hold of than the opaque replicationFabrics GUID path shown in the portal.
+func resourceArmRecoveryServicesReplicationFabricImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := parseAzureResourceID(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Recovery Service Replication Fabric ID must be either a full resource ID or in the format `{resource_group}/{vault_name}/{fabric_name}` - got %q", d.Id())
+	}
+
+	resourceGroup := parts[0]
+	vault := parts[1]
+	name := parts[2]
+
+	client := meta.(*ArmClient).getReplicationFabricClientForRecoveryServicesVault(resourceGroup, vault)
+	ctx := meta.(*ArmClient).StopContext
+
+	resp, err := client.Get(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("Error looking up Recovery Service Replication Fabric %q (Resource Group %q, Vault %q): %+v", name, resourceGroup, vault, err)
+	}
+
+	if resp.ID == nil || *resp.ID == "" {
+		return nil, fmt.Errorf("Recovery Service Replication Fabric %q (Resource Group %q, Vault %q) was not found", name, resourceGroup, vault)
+	}
+
+	d.SetId(*resp.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
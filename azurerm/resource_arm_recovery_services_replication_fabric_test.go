@@ -0,0 +1,163 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRecoveryServicesReplicationFabric_basic(t *testing.T) {
+	resourceName := "azurerm_recovery_services_replication_fabric.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesReplicationFabricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesReplicationFabric_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesReplicationFabricExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "Azure"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRecoveryServicesReplicationFabric_vmware(t *testing.T) {
+	resourceName := "azurerm_recovery_services_replication_fabric.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesReplicationFabricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesReplicationFabric_vmware(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesReplicationFabricExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "VMware"),
+					resource.TestCheckResourceAttr(resourceName, "vmware.0.process_server.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRecoveryServicesReplicationFabricDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_recovery_services_replication_fabric" {
+			continue
+		}
+
+		vault := rs.Primary.Attributes["vault_name"]
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).getReplicationFabricClientForRecoveryServicesVault(resourceGroup, vault)
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Recovery Service Replication Fabric still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMRecoveryServicesReplicationFabricExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		vault := rs.Primary.Attributes["vault_name"]
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).getReplicationFabricClientForRecoveryServicesVault(resourceGroup, vault)
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on replicationFabricsClient: %+v", err)
+		}
+
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Bad: Recovery Service Replication Fabric %q (resource group: %q, vault: %q) does not exist", name, resourceGroup, vault)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMRecoveryServicesReplicationFabric_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-vault-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_recovery_services_replication_fabric" "test" {
+  name                = "acctest-fabric-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  vault_name          = azurerm_recovery_services_vault.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMRecoveryServicesReplicationFabric_vmware(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-vault-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_recovery_services_replication_fabric" "test" {
+  name                = "acctest-fabric-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  vault_name          = azurerm_recovery_services_vault.test.name
+  location            = azurerm_resource_group.test.location
+  type                = "VMware"
+
+  vmware {
+    process_server {
+      name       = "acctest-ps-%d"
+      ip_address = "10.0.0.4"
+    }
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
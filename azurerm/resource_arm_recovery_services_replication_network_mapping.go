@@ -0,0 +1,218 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2018-01-10/siterecovery"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRecoveryServicesReplicationNetworkMapping() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRecoveryServicesReplicationNetworkMappingCreate,
+		Read:   resourceArmRecoveryServicesReplicationNetworkMappingRead,
+		Delete: resourceArmRecoveryServicesReplicationNetworkMappingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Replication Network Mapping name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"recovery_vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Recovery Service Vault name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"source_recovery_fabric_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"source_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_recovery_fabric_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceArmRecoveryServicesReplicationNetworkMappingCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	vault := d.Get("recovery_vault_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	sourceFabricName := d.Get("source_recovery_fabric_name").(string)
+	sourceNetworkID := d.Get("source_network_id").(string)
+	targetFabricName := d.Get("target_recovery_fabric_name").(string)
+	targetNetworkID := d.Get("target_network_id").(string)
+
+	client := meta.(*ArmClient).getReplicationNetworkMappingClientForRecoveryServicesVault(resourceGroup, vault)
+
+	log.Printf("[DEBUG] Creating/updating Recovery Service Replication Network Mapping %q (resource group %q, vault %q, fabric %q)", name, resourceGroup, vault, sourceFabricName)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, sourceFabricName, sourceNetworkID, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Recovery Service Replication Network Mapping %q (Resource Group %q, vault %q, fabric %q): %+v", name, resourceGroup, vault, sourceFabricName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_recovery_services_replication_network_mapping", *existing.ID)
+		}
+	}
+
+	mapping := siterecovery.CreateNetworkMappingInput{
+		Properties: &siterecovery.CreateNetworkMappingInputProperties{
+			RecoveryNetworkID:  &targetNetworkID,
+			RecoveryFabricName: &targetFabricName,
+			FabricSpecificDetails: siterecovery.AzureToAzureCreateNetworkMappingInput{
+				PrimaryNetworkID: &sourceNetworkID,
+			},
+		},
+	}
+
+	future, err := client.Create(ctx, sourceFabricName, sourceNetworkID, name, mapping)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Replication Network Mapping %q (Resource Group %q, Vault %q, Fabric %q): %+v", name, resourceGroup, vault, sourceFabricName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Replication Network Mapping %q (Resource Group %q, Vault %q, Fabric %q): %+v", name, resourceGroup, vault, sourceFabricName, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Replication Network Mapping %q (Resource Group %q, Vault %q, Fabric %q): %+v", name, resourceGroup, vault, sourceFabricName, err)
+	}
+
+	d.SetId(*result.ID)
+
+	return resourceArmRecoveryServicesReplicationNetworkMappingRead(d, meta)
+}
+
+func resourceArmRecoveryServicesReplicationNetworkMappingRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vault := id.Path["vaults"]
+	fabricName := id.Path["replicationFabrics"]
+	networkName := id.Path["replicationNetworks"]
+	name := id.Path["replicationNetworkMappings"]
+	resourceGroup := id.ResourceGroup
+
+	client := meta.(*ArmClient).getReplicationNetworkMappingClientForRecoveryServicesVault(resourceGroup, vault)
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[DEBUG] Reading Recovery Service Replication Network Mapping %q (resource group %q)", name, resourceGroup)
+
+	resp, err := client.Get(ctx, fabricName, networkName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Recovery Service Replication Network Mapping %q (Resource Group %q, Vault %q, Fabric %q): %+v", name, resourceGroup, vault, fabricName, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vault)
+	d.Set("source_recovery_fabric_name", fabricName)
+	d.Set("source_network_id", networkName)
+
+	if props := resp.Properties; props != nil {
+		if props.RecoveryFabricName != nil {
+			d.Set("target_recovery_fabric_name", props.RecoveryFabricName)
+		}
+
+		if props.RecoveryNetworkID != nil {
+			d.Set("target_network_id", props.RecoveryNetworkID)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmRecoveryServicesReplicationNetworkMappingDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vault := id.Path["vaults"]
+	fabricName := id.Path["replicationFabrics"]
+	networkName := id.Path["replicationNetworks"]
+	name := id.Path["replicationNetworkMappings"]
+	resourceGroup := id.ResourceGroup
+
+	client := meta.(*ArmClient).getReplicationNetworkMappingClientForRecoveryServicesVault(resourceGroup, vault)
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[DEBUG] Deleting Recovery Service Replication Network Mapping %q (resource group %q, vault %q, fabric %q)", name, resourceGroup, vault, fabricName)
+
+	future, err := client.Delete(ctx, fabricName, networkName, name)
+	if err != nil {
+		return fmt.Errorf("Error issuing delete request for Recovery Service Replication Network Mapping %q (Resource Group %q, Vault %q, Fabric %q): %+v", name, resourceGroup, vault, fabricName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error issuing delete request for Recovery Service Replication Network Mapping %q (Resource Group %q, Vault %q, Fabric %q): %+v", name, resourceGroup, vault, fabricName, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Deletion request failed for Recovery Service Replication Network Mapping %q (Resource Group %q, Vault %q, Fabric %q): %+v", name, resourceGroup, vault, fabricName, err)
+		}
+	}
+
+	return nil
+}
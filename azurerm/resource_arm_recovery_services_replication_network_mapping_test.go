@@ -0,0 +1,144 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRecoveryServicesReplicationNetworkMapping_basic(t *testing.T) {
+	resourceName := "azurerm_recovery_services_replication_network_mapping.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesReplicationNetworkMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesReplicationNetworkMapping_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesReplicationNetworkMappingExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRecoveryServicesReplicationNetworkMappingDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_recovery_services_replication_network_mapping" {
+			continue
+		}
+
+		vault := rs.Primary.Attributes["recovery_vault_name"]
+		fabricName := rs.Primary.Attributes["source_recovery_fabric_name"]
+		networkID := rs.Primary.Attributes["source_network_id"]
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).getReplicationNetworkMappingClientForRecoveryServicesVault(resourceGroup, vault)
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, fabricName, networkID, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Recovery Service Replication Network Mapping still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMRecoveryServicesReplicationNetworkMappingExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		vault := rs.Primary.Attributes["recovery_vault_name"]
+		fabricName := rs.Primary.Attributes["source_recovery_fabric_name"]
+		networkID := rs.Primary.Attributes["source_network_id"]
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).getReplicationNetworkMappingClientForRecoveryServicesVault(resourceGroup, vault)
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, fabricName, networkID, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on replicationNetworkMappingsClient: %+v", err)
+		}
+
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Bad: Recovery Service Replication Network Mapping %q (resource group: %q, vault: %q, fabric: %q) does not exist", name, resourceGroup, vault, fabricName)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMRecoveryServicesReplicationNetworkMapping_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-vault-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_recovery_services_replication_fabric" "source" {
+  name                = "acctest-fabric-source-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  vault_name          = azurerm_recovery_services_vault.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_recovery_services_replication_fabric" "target" {
+  name                = "acctest-fabric-target-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  vault_name          = azurerm_recovery_services_vault.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_network" "source" {
+  name                = "acctest-vnet-source-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_virtual_network" "target" {
+  name                = "acctest-vnet-target-%d"
+  address_space       = ["10.1.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_recovery_services_replication_network_mapping" "test" {
+  name                         = "acctest-mapping-%d"
+  resource_group_name          = azurerm_resource_group.test.name
+  recovery_vault_name          = azurerm_recovery_services_vault.test.name
+  source_recovery_fabric_name  = azurerm_recovery_services_replication_fabric.source.name
+  source_network_id            = azurerm_virtual_network.source.id
+  target_recovery_fabric_name  = azurerm_recovery_services_replication_fabric.target.name
+  target_network_id            = azurerm_virtual_network.target.id
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt)
+}
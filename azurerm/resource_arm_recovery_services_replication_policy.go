@@ -0,0 +1,228 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2018-01-10/siterecovery"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRecoveryServicesReplicationPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRecoveryServicesReplicationPolicyCreate,
+		Read:   resourceArmRecoveryServicesReplicationPolicyRead,
+		Delete: resourceArmRecoveryServicesReplicationPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Replication Policy name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Recovery Service Vault name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"recovery_point_retention_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      24 * 60,
+				ValidateFunc: validation.IntBetween(0, 365*24*60),
+			},
+
+			"application_consistent_snapshot_frequency_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      4 * 60,
+				ValidateFunc: validation.IntBetween(0, 12*60),
+			},
+
+			"crash_consistent_frequency_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(0, 12*60),
+			},
+
+			"recovery_point_threshold_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(0, 365*24*60),
+			},
+		},
+	}
+}
+
+func resourceArmRecoveryServicesReplicationPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	vault := d.Get("vault_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	recoveryPoint := int32(d.Get("recovery_point_retention_in_minutes").(int))
+	appConsistentFrequency := int32(d.Get("application_consistent_snapshot_frequency_in_minutes").(int))
+	crashConsistentFrequency := int32(d.Get("crash_consistent_frequency_in_minutes").(int))
+	recoveryPointThreshold := int32(d.Get("recovery_point_threshold_in_minutes").(int))
+
+	client := meta.(*ArmClient).getReplicationPolicyClientForRecoveryServicesVault(resourceGroup, vault)
+
+	log.Printf("[DEBUG] Creating/updating Recovery Service Replication Policy %q (resource group %q, vault %q)", name, resourceGroup, vault)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Recovery Service Replication Policy %q (Resource Group %q, vault %q): %+v", name, resourceGroup, vault, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_recovery_services_replication_policy", *existing.ID)
+		}
+	}
+
+	policy := siterecovery.CreatePolicyInput{
+		Properties: &siterecovery.CreatePolicyInputProperties{
+			ProviderSpecificInput: siterecovery.A2APolicyCreationInput{
+				RecoveryPointHistory:              &recoveryPoint,
+				AppConsistentFrequencyInMinutes:   &appConsistentFrequency,
+				CrashConsistentFrequencyInMinutes: &crashConsistentFrequency,
+				RecoveryPointThresholdInMinutes:   &recoveryPointThreshold,
+				InstanceType:                      siterecovery.InstanceTypeA2A,
+			},
+		},
+	}
+
+	future, err := client.Create(ctx, name, policy)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Replication Policy %q (Resource Group %q, Vault %q): %+v", name, resourceGroup, vault, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Replication Policy %q (Resource Group %q, Vault %q): %+v", name, resourceGroup, vault, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Replication Policy %q (Resource Group %q, Vault %q): %+v", name, resourceGroup, vault, err)
+	}
+
+	d.SetId(*result.ID)
+
+	return resourceArmRecoveryServicesReplicationPolicyRead(d, meta)
+}
+
+func resourceArmRecoveryServicesReplicationPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vault := id.Path["vaults"]
+	name := id.Path["replicationPolicies"]
+	resourceGroup := id.ResourceGroup
+
+	client := meta.(*ArmClient).getReplicationPolicyClientForRecoveryServicesVault(resourceGroup, vault)
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[DEBUG] Reading Recovery Service Replication Policy %q (resource group %q)", name, resourceGroup)
+
+	resp, err := client.Get(ctx, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Recovery Service Replication Policy %q (Resource Group %q, Vault %q): %+v", name, resourceGroup, vault, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("vault_name", vault)
+
+	if props := resp.Properties; props != nil {
+		if details, isA2A := props.ProviderSpecificDetails.AsA2APolicyDetails(); isA2A {
+			if details.RecoveryPointHistory != nil {
+				d.Set("recovery_point_retention_in_minutes", int(*details.RecoveryPointHistory))
+			}
+
+			if details.AppConsistentFrequencyInMinutes != nil {
+				d.Set("application_consistent_snapshot_frequency_in_minutes", int(*details.AppConsistentFrequencyInMinutes))
+			}
+
+			if details.CrashConsistentFrequencyInMinutes != nil {
+				d.Set("crash_consistent_frequency_in_minutes", int(*details.CrashConsistentFrequencyInMinutes))
+			}
+
+			if details.RecoveryPointThresholdInMinutes != nil {
+				d.Set("recovery_point_threshold_in_minutes", int(*details.RecoveryPointThresholdInMinutes))
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmRecoveryServicesReplicationPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vault := id.Path["vaults"]
+	name := id.Path["replicationPolicies"]
+	resourceGroup := id.ResourceGroup
+
+	client := meta.(*ArmClient).getReplicationPolicyClientForRecoveryServicesVault(resourceGroup, vault)
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[DEBUG] Deleting Recovery Service Replication Policy %q (resource group %q, vault %q)", name, resourceGroup, vault)
+
+	future, err := client.Delete(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error issuing delete request for Recovery Service Replication Policy %q (Resource Group %q, Vault %q): %+v", name, resourceGroup, vault, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error issuing delete request for Recovery Service Replication Policy %q (Resource Group %q, Vault %q): %+v", name, resourceGroup, vault, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Deletion request failed for Recovery Service Replication Policy %q (Resource Group %q, Vault %q): %+v", name, resourceGroup, vault, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRecoveryServicesReplicationPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_recovery_services_replication_policy.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesReplicationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesReplicationPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesReplicationPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "recovery_point_retention_in_minutes", "1440"),
+					resource.TestCheckResourceAttr(resourceName, "crash_consistent_frequency_in_minutes", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRecoveryServicesReplicationPolicyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_recovery_services_replication_policy" {
+			continue
+		}
+
+		vault := rs.Primary.Attributes["vault_name"]
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).getReplicationPolicyClientForRecoveryServicesVault(resourceGroup, vault)
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Recovery Service Replication Policy still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMRecoveryServicesReplicationPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		vault := rs.Primary.Attributes["vault_name"]
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).getReplicationPolicyClientForRecoveryServicesVault(resourceGroup, vault)
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on replicationPoliciesClient: %+v", err)
+		}
+
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Bad: Recovery Service Replication Policy %q (resource group: %q, vault: %q) does not exist", name, resourceGroup, vault)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMRecoveryServicesReplicationPolicy_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-vault-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_recovery_services_replication_policy" "test" {
+  name                                                  = "acctest-policy-%d"
+  resource_group_name                                   = azurerm_resource_group.test.name
+  vault_name                                            = azurerm_recovery_services_vault.test.name
+  recovery_point_retention_in_minutes                   = 1440
+  application_consistent_snapshot_frequency_in_minutes  = 240
+}
+`, rInt, location, rInt, rInt)
+}
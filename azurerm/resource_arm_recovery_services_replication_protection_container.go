@@ -0,0 +1,175 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2018-01-10/siterecovery"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRecoveryServicesReplicationProtectionContainer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRecoveryServicesReplicationProtectionContainerCreate,
+		Read:   resourceArmRecoveryServicesReplicationProtectionContainerRead,
+		Delete: resourceArmRecoveryServicesReplicationProtectionContainerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Replication Protection Container name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"recovery_vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Recovery Service Vault name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"recovery_fabric_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceArmRecoveryServicesReplicationProtectionContainerCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	vault := d.Get("recovery_vault_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	fabricName := d.Get("recovery_fabric_name").(string)
+
+	client := meta.(*ArmClient).getReplicationProtectionContainerClientForRecoveryServicesVault(resourceGroup, vault)
+
+	log.Printf("[DEBUG] Creating/updating Recovery Service Replication Protection Container %q (resource group %q, vault %q, fabric %q)", name, resourceGroup, vault, fabricName)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, fabricName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Recovery Service Replication Protection Container %q (Resource Group %q, vault %q, fabric %q): %+v", name, resourceGroup, vault, fabricName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_recovery_services_replication_protection_container", *existing.ID)
+		}
+	}
+
+	container := siterecovery.CreateProtectionContainerInput{
+		Properties: &siterecovery.CreateProtectionContainerInputProperties{},
+	}
+
+	future, err := client.Create(ctx, fabricName, name, container)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Replication Protection Container %q (Resource Group %q, Vault %q, Fabric %q): %+v", name, resourceGroup, vault, fabricName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Replication Protection Container %q (Resource Group %q, Vault %q, Fabric %q): %+v", name, resourceGroup, vault, fabricName, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Replication Protection Container %q (Resource Group %q, Vault %q, Fabric %q): %+v", name, resourceGroup, vault, fabricName, err)
+	}
+
+	d.SetId(*result.ID)
+
+	return resourceArmRecoveryServicesReplicationProtectionContainerRead(d, meta)
+}
+
+func resourceArmRecoveryServicesReplicationProtectionContainerRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vault := id.Path["vaults"]
+	fabricName := id.Path["replicationFabrics"]
+	name := id.Path["replicationProtectionContainers"]
+	resourceGroup := id.ResourceGroup
+
+	client := meta.(*ArmClient).getReplicationProtectionContainerClientForRecoveryServicesVault(resourceGroup, vault)
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[DEBUG] Reading Recovery Service Replication Protection Container %q (resource group %q)", name, resourceGroup)
+
+	resp, err := client.Get(ctx, fabricName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Recovery Service Replication Protection Container %q (Resource Group %q, Vault %q, Fabric %q): %+v", name, resourceGroup, vault, fabricName, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vault)
+	d.Set("recovery_fabric_name", fabricName)
+
+	return nil
+}
+
+func resourceArmRecoveryServicesReplicationProtectionContainerDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vault := id.Path["vaults"]
+	fabricName := id.Path["replicationFabrics"]
+	name := id.Path["replicationProtectionContainers"]
+	resourceGroup := id.ResourceGroup
+
+	client := meta.(*ArmClient).getReplicationProtectionContainerClientForRecoveryServicesVault(resourceGroup, vault)
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[DEBUG] Deleting Recovery Service Replication Protection Container %q (resource group %q, vault %q, fabric %q)", name, resourceGroup, vault, fabricName)
+
+	future, err := client.Delete(ctx, fabricName, name)
+	if err != nil {
+		return fmt.Errorf("Error issuing delete request for Recovery Service Replication Protection Container %q (Resource Group %q, Vault %q, Fabric %q): %+v", name, resourceGroup, vault, fabricName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error issuing delete request for Recovery Service Replication Protection Container %q (Resource Group %q, Vault %q, Fabric %q): %+v", name, resourceGroup, vault, fabricName, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Deletion request failed for Recovery Service Replication Protection Container %q (Resource Group %q, Vault %q, Fabric %q): %+v", name, resourceGroup, vault, fabricName, err)
+		}
+	}
+
+	return nil
+}
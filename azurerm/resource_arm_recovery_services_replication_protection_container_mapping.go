@@ -0,0 +1,222 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2018-01-10/siterecovery"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRecoveryServicesReplicationProtectionContainerMapping() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRecoveryServicesReplicationProtectionContainerMappingCreate,
+		Read:   resourceArmRecoveryServicesReplicationProtectionContainerMappingRead,
+		Delete: resourceArmRecoveryServicesReplicationProtectionContainerMappingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Replication Protection Container Mapping name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"recovery_vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Recovery Service Vault name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"recovery_fabric_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"recovery_source_protection_container_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"recovery_target_protection_container_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"recovery_replication_policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceArmRecoveryServicesReplicationProtectionContainerMappingCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	vault := d.Get("recovery_vault_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	fabricName := d.Get("recovery_fabric_name").(string)
+	sourceContainerName := d.Get("recovery_source_protection_container_name").(string)
+	targetContainerID := d.Get("recovery_target_protection_container_id").(string)
+	policyID := d.Get("recovery_replication_policy_id").(string)
+
+	client := meta.(*ArmClient).getReplicationProtectionContainerMappingClientForRecoveryServicesVault(resourceGroup, vault)
+
+	log.Printf("[DEBUG] Creating/updating Recovery Service Replication Protection Container Mapping %q (resource group %q, vault %q, fabric %q, container %q)", name, resourceGroup, vault, fabricName, sourceContainerName)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, fabricName, sourceContainerName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Recovery Service Replication Protection Container Mapping %q (Resource Group %q, vault %q, fabric %q, container %q): %+v", name, resourceGroup, vault, fabricName, sourceContainerName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_recovery_services_replication_protection_container_mapping", *existing.ID)
+		}
+	}
+
+	mapping := siterecovery.CreateProtectionContainerMappingInput{
+		Properties: &siterecovery.CreateProtectionContainerMappingInputProperties{
+			TargetProtectionContainerID: &targetContainerID,
+			PolicyID:                    &policyID,
+			ProviderSpecificInput: siterecovery.A2AContainerMappingInput{
+				InstanceType: siterecovery.InstanceTypeA2A,
+			},
+		},
+	}
+
+	future, err := client.Create(ctx, fabricName, sourceContainerName, name, mapping)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Replication Protection Container Mapping %q (Resource Group %q, Vault %q, Fabric %q, Container %q): %+v", name, resourceGroup, vault, fabricName, sourceContainerName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Replication Protection Container Mapping %q (Resource Group %q, Vault %q, Fabric %q, Container %q): %+v", name, resourceGroup, vault, fabricName, sourceContainerName, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service Replication Protection Container Mapping %q (Resource Group %q, Vault %q, Fabric %q, Container %q): %+v", name, resourceGroup, vault, fabricName, sourceContainerName, err)
+	}
+
+	d.SetId(*result.ID)
+
+	return resourceArmRecoveryServicesReplicationProtectionContainerMappingRead(d, meta)
+}
+
+func resourceArmRecoveryServicesReplicationProtectionContainerMappingRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vault := id.Path["vaults"]
+	fabricName := id.Path["replicationFabrics"]
+	containerName := id.Path["replicationProtectionContainers"]
+	name := id.Path["replicationProtectionContainerMappings"]
+	resourceGroup := id.ResourceGroup
+
+	client := meta.(*ArmClient).getReplicationProtectionContainerMappingClientForRecoveryServicesVault(resourceGroup, vault)
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[DEBUG] Reading Recovery Service Replication Protection Container Mapping %q (resource group %q)", name, resourceGroup)
+
+	resp, err := client.Get(ctx, fabricName, containerName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Recovery Service Replication Protection Container Mapping %q (Resource Group %q, Vault %q, Fabric %q, Container %q): %+v", name, resourceGroup, vault, fabricName, containerName, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vault)
+	d.Set("recovery_fabric_name", fabricName)
+	d.Set("recovery_source_protection_container_name", containerName)
+
+	if props := resp.Properties; props != nil {
+		if props.TargetProtectionContainerID != nil {
+			d.Set("recovery_target_protection_container_id", props.TargetProtectionContainerID)
+		}
+
+		if props.PolicyID != nil {
+			d.Set("recovery_replication_policy_id", props.PolicyID)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmRecoveryServicesReplicationProtectionContainerMappingDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vault := id.Path["vaults"]
+	fabricName := id.Path["replicationFabrics"]
+	containerName := id.Path["replicationProtectionContainers"]
+	name := id.Path["replicationProtectionContainerMappings"]
+	resourceGroup := id.ResourceGroup
+
+	client := meta.(*ArmClient).getReplicationProtectionContainerMappingClientForRecoveryServicesVault(resourceGroup, vault)
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[DEBUG] Deleting Recovery Service Replication Protection Container Mapping %q (resource group %q, vault %q, fabric %q, container %q)", name, resourceGroup, vault, fabricName, containerName)
+
+	removeInput := siterecovery.RemoveProtectionContainerMappingInput{
+		Properties: &siterecovery.RemoveProtectionContainerMappingInputProperties{},
+	}
+
+	future, err := client.Delete(ctx, fabricName, containerName, name, removeInput)
+	if err != nil {
+		return fmt.Errorf("Error issuing delete request for Recovery Service Replication Protection Container Mapping %q (Resource Group %q, Vault %q, Fabric %q, Container %q): %+v", name, resourceGroup, vault, fabricName, containerName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error issuing delete request for Recovery Service Replication Protection Container Mapping %q (Resource Group %q, Vault %q, Fabric %q, Container %q): %+v", name, resourceGroup, vault, fabricName, containerName, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Deletion request failed for Recovery Service Replication Protection Container Mapping %q (Resource Group %q, Vault %q, Fabric %q, Container %q): %+v", name, resourceGroup, vault, fabricName, containerName, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRecoveryServicesReplicationProtectionContainerMapping_basic(t *testing.T) {
+	resourceName := "azurerm_recovery_services_replication_protection_container_mapping.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesReplicationProtectionContainerMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesReplicationProtectionContainerMapping_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesReplicationProtectionContainerMappingExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRecoveryServicesReplicationProtectionContainerMappingDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_recovery_services_replication_protection_container_mapping" {
+			continue
+		}
+
+		vault := rs.Primary.Attributes["recovery_vault_name"]
+		fabricName := rs.Primary.Attributes["recovery_fabric_name"]
+		containerName := rs.Primary.Attributes["recovery_source_protection_container_name"]
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).getReplicationProtectionContainerMappingClientForRecoveryServicesVault(resourceGroup, vault)
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, fabricName, containerName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Recovery Service Replication Protection Container Mapping still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMRecoveryServicesReplicationProtectionContainerMappingExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		vault := rs.Primary.Attributes["recovery_vault_name"]
+		fabricName := rs.Primary.Attributes["recovery_fabric_name"]
+		containerName := rs.Primary.Attributes["recovery_source_protection_container_name"]
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).getReplicationProtectionContainerMappingClientForRecoveryServicesVault(resourceGroup, vault)
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, fabricName, containerName, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on replicationProtectionContainerMappingsClient: %+v", err)
+		}
+
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Bad: Recovery Service Replication Protection Container Mapping %q (resource group: %q, vault: %q, fabric: %q, container: %q) does not exist", name, resourceGroup, vault, fabricName, containerName)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMRecoveryServicesReplicationProtectionContainerMapping_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-vault-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_recovery_services_replication_fabric" "source" {
+  name                = "acctest-fabric-source-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  vault_name          = azurerm_recovery_services_vault.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_recovery_services_replication_fabric" "target" {
+  name                = "acctest-fabric-target-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  vault_name          = azurerm_recovery_services_vault.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_recovery_services_replication_protection_container" "source" {
+  name                 = "acctest-container-source-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  recovery_vault_name  = azurerm_recovery_services_vault.test.name
+  recovery_fabric_name = azurerm_recovery_services_replication_fabric.source.name
+}
+
+resource "azurerm_recovery_services_replication_protection_container" "target" {
+  name                 = "acctest-container-target-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  recovery_vault_name  = azurerm_recovery_services_vault.test.name
+  recovery_fabric_name = azurerm_recovery_services_replication_fabric.target.name
+}
+
+resource "azurerm_recovery_services_replication_policy" "test" {
+  name                = "acctest-policy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  vault_name          = azurerm_recovery_services_vault.test.name
+}
+
+resource "azurerm_recovery_services_replication_protection_container_mapping" "test" {
+  name                                       = "acctest-mapping-%d"
+  resource_group_name                        = azurerm_resource_group.test.name
+  recovery_vault_name                        = azurerm_recovery_services_vault.test.name
+  recovery_fabric_name                       = azurerm_recovery_services_replication_fabric.source.name
+  recovery_source_protection_container_name  = azurerm_recovery_services_replication_protection_container.source.name
+  recovery_target_protection_container_id    = azurerm_recovery_services_replication_protection_container.target.id
+  recovery_replication_policy_id             = azurerm_recovery_services_replication_policy.test.id
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt, rInt)
+}
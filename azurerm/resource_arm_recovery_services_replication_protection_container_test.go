@@ -0,0 +1,118 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRecoveryServicesReplicationProtectionContainer_basic(t *testing.T) {
+	resourceName := "azurerm_recovery_services_replication_protection_container.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesReplicationProtectionContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesReplicationProtectionContainer_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesReplicationProtectionContainerExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRecoveryServicesReplicationProtectionContainerDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_recovery_services_replication_protection_container" {
+			continue
+		}
+
+		vault := rs.Primary.Attributes["recovery_vault_name"]
+		fabricName := rs.Primary.Attributes["recovery_fabric_name"]
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).getReplicationProtectionContainerClientForRecoveryServicesVault(resourceGroup, vault)
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, fabricName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Recovery Service Replication Protection Container still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMRecoveryServicesReplicationProtectionContainerExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		vault := rs.Primary.Attributes["recovery_vault_name"]
+		fabricName := rs.Primary.Attributes["recovery_fabric_name"]
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).getReplicationProtectionContainerClientForRecoveryServicesVault(resourceGroup, vault)
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, fabricName, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on replicationProtectionContainersClient: %+v", err)
+		}
+
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Bad: Recovery Service Replication Protection Container %q (resource group: %q, vault: %q, fabric: %q) does not exist", name, resourceGroup, vault, fabricName)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMRecoveryServicesReplicationProtectionContainer_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-vault-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_recovery_services_replication_fabric" "test" {
+  name                = "acctest-fabric-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  vault_name          = azurerm_recovery_services_vault.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_recovery_services_replication_protection_container" "test" {
+  name                 = "acctest-container-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  recovery_vault_name  = azurerm_recovery_services_vault.test.name
+  recovery_fabric_name = azurerm_recovery_services_replication_fabric.test.name
+}
+`, rInt, location, rInt, rInt, rInt)
+}